@@ -0,0 +1,69 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"sigs.k8s.io/external-dns/endpoint"
+	"sigs.k8s.io/external-dns/pkg/apis/externaldns"
+	"sigs.k8s.io/external-dns/provider"
+	"sigs.k8s.io/external-dns/provider/efficientip"
+)
+
+func main() {
+	cfg := externaldns.NewConfig()
+	if err := cfg.ParseFlags(os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+
+	p, err := buildProvider(cfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_ = p
+}
+
+// buildProvider constructs the DNS provider selected by cfg.Provider.
+func buildProvider(cfg *externaldns.Config) (provider.Provider, error) {
+	domainFilter := endpoint.NewDomainFilter(nil)
+	zoneIDFilter := provider.NewZoneIDFilter(nil)
+
+	switch cfg.Provider {
+	case "efficientip":
+		return efficientip.NewEfficientIPProvider(efficientip.EfficientIPConfig{
+			DomainFilter:      domainFilter,
+			ZoneIDFilter:      zoneIDFilter,
+			Host:              cfg.EfficientIPHost,
+			Port:              cfg.EfficientIPPort,
+			Username:          cfg.EfficientIPUsername,
+			Password:          cfg.EfficientIPPassword,
+			SSlVerify:         cfg.EfficientIPSSLVerify,
+			View:              cfg.EfficientIPDNSView,
+			ViewFilter:        cfg.EfficientIPDNSViewFilter,
+			MaxParallel:       cfg.EfficientIPMaxParallel,
+			ManagePTR:         cfg.EfficientIPManagePTR,
+			ReverseCIDRFilter: cfg.EfficientIPReverseCIDRFilter,
+		})
+	default:
+		return nil, fmt.Errorf("unknown provider: %s", cfg.Provider)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externaldns
+
+import (
+	kingpin "gopkg.in/alecthomas/kingpin.v2"
+)
+
+// Config holds the values parsed from the command line and environment that
+// drive provider selection and configuration.
+type Config struct {
+	Provider string
+
+	EfficientIPHost              string
+	EfficientIPPort              int
+	EfficientIPUsername          string
+	EfficientIPPassword          string
+	EfficientIPSSLVerify         bool
+	EfficientIPDNSView           string
+	EfficientIPDNSViewFilter     string
+	EfficientIPMaxParallel       int
+	EfficientIPManagePTR         bool
+	EfficientIPReverseCIDRFilter []string
+}
+
+// NewConfig returns an empty Config; flag defaults are filled in by ParseFlags.
+func NewConfig() *Config {
+	return &Config{}
+}
+
+// ParseFlags fills in Config from the given command-line arguments.
+func (cfg *Config) ParseFlags(args []string) error {
+	app := kingpin.New("external-dns", "ExternalDNS synchronizes exposed Kubernetes Services and Ingresses with DNS providers.")
+
+	app.Flag("provider", "The DNS provider where the DNS records will be created (required)").Required().StringVar(&cfg.Provider)
+
+	app.Flag("efficientip-host", "When using the EfficientIP provider, specify the SOLIDserver host").StringVar(&cfg.EfficientIPHost)
+	app.Flag("efficientip-port", "When using the EfficientIP provider, specify the SOLIDserver port").Default("443").IntVar(&cfg.EfficientIPPort)
+	app.Flag("efficientip-username", "When using the EfficientIP provider, specify the SOLIDserver username").StringVar(&cfg.EfficientIPUsername)
+	app.Flag("efficientip-password", "When using the EfficientIP provider, specify the SOLIDserver password").StringVar(&cfg.EfficientIPPassword)
+	app.Flag("efficientip-ssl-verify", "When using the EfficientIP provider, verify the SOLIDserver certificate").Default("true").BoolVar(&cfg.EfficientIPSSLVerify)
+	app.Flag("efficientip-dns-view", "When using the EfficientIP provider, restrict zone and record management to this SOLIDserver DNS View").StringVar(&cfg.EfficientIPDNSView)
+	app.Flag("efficientip-dns-view-filter", "When using the EfficientIP provider, only consider views whose name contains this substring").StringVar(&cfg.EfficientIPDNSViewFilter)
+	app.Flag("efficientip-max-parallel", "When using the EfficientIP provider, the maximum number of zones/records fetched or mutated concurrently").Default("8").IntVar(&cfg.EfficientIPMaxParallel)
+	app.Flag("efficientip-manage-ptr", "When using the EfficientIP provider, discover reverse zones and keep their PTR records in sync with managed A/AAAA records").BoolVar(&cfg.EfficientIPManagePTR)
+	app.Flag("efficientip-reverse-cidr-filter", "When using the EfficientIP provider, restrict PTR management to the reverse zones covering these CIDRs (can be repeated)").StringsVar(&cfg.EfficientIPReverseCIDRFilter)
+
+	_, err := app.Parse(args)
+	return err
+}
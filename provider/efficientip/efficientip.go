@@ -19,11 +19,19 @@ package efficientip
 import (
 	"context"
 	"crypto/tls"
+	"fmt"
+	"math/big"
+	"net"
 	"net/http"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
 	eip "sdsclient"
 
 	"sigs.k8s.io/external-dns/endpoint"
@@ -31,6 +39,182 @@ import (
 	"sigs.k8s.io/external-dns/provider"
 )
 
+// defaultMaxParallel is used when EfficientIPConfig.MaxParallel is left unset.
+const defaultMaxParallel = 8
+
+// parallelism returns p.maxParallel, falling back to defaultMaxParallel for
+// providers built without going through NewEfficientIPProvider (e.g. in
+// tests), so a zero value never leaves the worker pool's semaphore
+// unbuffered and deadlocked.
+func (p *EfficientIPProvider) parallelism() int {
+	if p.maxParallel <= 0 {
+		return defaultMaxParallel
+	}
+	return p.maxParallel
+}
+
+const maxRetries = 3
+
+// retryableStatusCode reports whether resp indicates a transient SOLIDserver
+// failure (5xx or 429) worth retrying.
+func retryableStatusCode(resp *http.Response) bool {
+	return resp != nil && (resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests)
+}
+
+// retryOnTransientError calls fn up to maxRetries times with exponential
+// backoff whenever the SOLIDserver response looks transient (5xx/429).
+func retryOnTransientError[T any](fn func() (T, *http.Response, error)) (T, *http.Response, error) {
+	var (
+		data T
+		resp *http.Response
+		err  error
+	)
+	backoff := 200 * time.Millisecond
+	for attempt := 0; attempt < maxRetries; attempt++ {
+		data, resp, err = fn()
+		if err == nil || !retryableStatusCode(resp) {
+			return data, resp, err
+		}
+		if attempt == maxRetries-1 {
+			break
+		}
+		log.Warnf("SOLIDserver request failed with a transient error (%v), retrying in %s", err, backoff)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	return data, resp, err
+}
+
+// multiValueRecordTypes are the record types for which SOLIDserver returns one
+// RR per target; they are aggregated back into a single Endpoint keyed on
+// (name, type) rather than one Endpoint per RR.
+var multiValueRecordTypes = map[string]bool{
+	endpoint.RecordTypeA:     true,
+	endpoint.RecordTypeAAAA:  true,
+	endpoint.RecordTypeCNAME: true,
+	endpoint.RecordTypeTXT:   true,
+	endpoint.RecordTypeNS:    true,
+	endpoint.RecordTypeMX:    true,
+	endpoint.RecordTypeSRV:   true,
+	"CAA":                    true,
+	endpoint.RecordTypePTR:   true,
+}
+
+// isReverseZone reports whether a zone name is an in-addr.arpa/ip6.arpa
+// reverse zone rather than a forward zone.
+func isReverseZone(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	return strings.HasSuffix(name, "in-addr.arpa") || strings.HasSuffix(name, "ip6.arpa")
+}
+
+// matchesAnySuffix reports whether name (after trimming a trailing dot) equals
+// or ends, at a label boundary, with one of suffixes.
+func matchesAnySuffix(name string, suffixes []string) bool {
+	name = strings.TrimSuffix(name, ".")
+	for _, suffix := range suffixes {
+		if name == suffix || strings.HasSuffix(name, "."+suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// cidrToArpaSuffix translates a forward CIDR (e.g. "192.168.0.0/24") into the
+// in-addr.arpa/ip6.arpa zone suffix SOLIDserver would use for it, so reverse
+// zones can be filtered the same way forward zones are filtered by domain.
+func cidrToArpaSuffix(cidr string) (string, error) {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("invalid reverse CIDR %q: %w", cidr, err)
+	}
+	ones, _ := ipNet.Mask.Size()
+
+	if ip4 := ipNet.IP.To4(); ip4 != nil {
+		if ones%8 != 0 {
+			return "", fmt.Errorf("reverse CIDR %q must be octet-aligned (/8, /16, /24, /32)", cidr)
+		}
+		octets := ones / 8
+		parts := make([]string, 0, octets)
+		for i := octets - 1; i >= 0; i-- {
+			parts = append(parts, strconv.Itoa(int(ip4[i])))
+		}
+		return strings.Join(parts, ".") + ".in-addr.arpa", nil
+	}
+
+	if ones%4 != 0 {
+		return "", fmt.Errorf("reverse CIDR %q must be nibble-aligned", cidr)
+	}
+	nibbles := ones / 4
+	hex := fmt.Sprintf("%032x", new(big.Int).SetBytes(ipNet.IP.To16()))
+	parts := make([]string, 0, nibbles)
+	for i := nibbles - 1; i >= 0; i-- {
+		parts = append(parts, string(hex[i]))
+	}
+	return strings.Join(parts, ".") + ".ip6.arpa", nil
+}
+
+// reverseNameForIP builds the in-addr.arpa/ip6.arpa owner name for an IP
+// address, e.g. "4.3.2.1.in-addr.arpa" for "1.2.3.4".
+func reverseNameForIP(ip string) (string, error) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return "", fmt.Errorf("invalid IP %q", ip)
+	}
+
+	if ip4 := parsed.To4(); ip4 != nil && strings.Count(ip, ":") == 0 {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa", ip4[3], ip4[2], ip4[1], ip4[0]), nil
+	}
+
+	hex := fmt.Sprintf("%032x", new(big.Int).SetBytes(parsed.To16()))
+	nibbles := make([]string, 0, len(hex))
+	for i := len(hex) - 1; i >= 0; i-- {
+		nibbles = append(nibbles, string(hex[i]))
+	}
+	return strings.Join(nibbles, ".") + ".ip6.arpa", nil
+}
+
+// rrValueFromFields reconstitutes the RDATA string ExternalDNS expects for
+// record types that SOLIDserver splits across RrValue1..RrValue4.
+func rrValueFromFields(rrType, value1, value2, value3, value4 string) string {
+	switch rrType {
+	case endpoint.RecordTypeMX:
+		return fmt.Sprintf("%s %s", value1, value2)
+	case endpoint.RecordTypeSRV:
+		return fmt.Sprintf("%s %s %s %s", value1, value2, value3, value4)
+	case "CAA":
+		return fmt.Sprintf("%s %s %q", value1, value2, value3)
+	default:
+		return value1
+	}
+}
+
+// rrFieldsFromValue splits a composite Endpoint target back into the
+// RrValue1..RrValue4 fields the SOLIDserver API expects.
+func rrFieldsFromValue(rrType, value string) (v1, v2, v3, v4 string, err error) {
+	switch rrType {
+	case endpoint.RecordTypeMX:
+		fields := strings.SplitN(value, " ", 2)
+		if len(fields) != 2 {
+			return "", "", "", "", fmt.Errorf("invalid MX value %q", value)
+		}
+		return fields[0], fields[1], "", "", nil
+	case endpoint.RecordTypeSRV:
+		fields := strings.SplitN(value, " ", 4)
+		if len(fields) != 4 {
+			return "", "", "", "", fmt.Errorf("invalid SRV value %q", value)
+		}
+		return fields[0], fields[1], fields[2], fields[3], nil
+	case "CAA":
+		fields := strings.SplitN(value, " ", 3)
+		if len(fields) != 3 {
+			return "", "", "", "", fmt.Errorf("invalid CAA value %q", value)
+		}
+		return fields[0], fields[1], strings.Trim(fields[2], "\""), "", nil
+	default:
+		return value, "", "", "", nil
+	}
+}
+
 type EfficientIPConfig struct {
 	DomainFilter endpoint.DomainFilter
 	ZoneIDFilter provider.ZoneIDFilter
@@ -40,6 +224,26 @@ type EfficientIPConfig struct {
 	Username     string
 	Password     string
 	SSlVerify    bool
+	// View restricts zone and record management to a single SOLIDserver DNS
+	// View (smart architecture), matched by exact name. When empty, zones of
+	// every view are considered (subject to ViewFilter).
+	View string
+	// ViewFilter keeps only zones whose view name contains this substring.
+	// It is applied whether or not View is set.
+	ViewFilter string
+	// MaxParallel bounds how many zones/records are fetched or mutated
+	// concurrently. Defaults to defaultMaxParallel when left at zero.
+	MaxParallel int
+	// ManagePTR discovers in-addr.arpa/ip6.arpa zones and keeps their PTR
+	// records in sync with managed A/AAAA records. PTRs are maintained
+	// out-of-band as companions (see maybeSyncPTR) and are never reported by
+	// Records(), so they stay outside the ExternalDNS plan/registry entirely.
+	ManagePTR bool
+	// ReverseCIDRFilter restricts PTR management to the reverse zones
+	// covering these CIDRs. Each entry is translated to its corresponding
+	// arpa suffix. Entries are always validated, but have no effect when
+	// ManagePTR is false; unrestricted when empty.
+	ReverseCIDRFilter []string
 }
 
 type EfficientIPProvider struct {
@@ -49,6 +253,11 @@ type EfficientIPProvider struct {
 	dryRun       bool
 	client       *eip.APIClient
 	context      context.Context
+	view         string
+	viewFilter   string
+	maxParallel  int
+	managePTR    bool
+	reverseZones []string
 }
 
 func NewEfficientIPProvider(config EfficientIPConfig) (*EfficientIPProvider, error) {
@@ -69,12 +278,31 @@ func NewEfficientIPProvider(config EfficientIPConfig) (*EfficientIPProvider, err
 		"port":                  strconv.Itoa(config.Port),
 	})
 
+	maxParallel := config.MaxParallel
+	if maxParallel <= 0 {
+		maxParallel = defaultMaxParallel
+	}
+
+	var reverseZones []string
+	for _, cidr := range config.ReverseCIDRFilter {
+		suffix, err := cidrToArpaSuffix(cidr)
+		if err != nil {
+			return nil, err
+		}
+		reverseZones = append(reverseZones, suffix)
+	}
+
 	eipProvider := &EfficientIPProvider{
 		domainFilter: config.DomainFilter,
 		zoneIDFilter: config.ZoneIDFilter,
 		dryRun:       config.DryRun,
 		client:       client,
 		context:      ctx,
+		view:         config.View,
+		viewFilter:   config.ViewFilter,
+		maxParallel:  maxParallel,
+		managePTR:    config.ManagePTR,
+		reverseZones: reverseZones,
 	}
 	return eipProvider, nil
 }
@@ -83,6 +311,7 @@ type ZoneAuth struct {
 	Name string
 	Type string
 	ID   string
+	View string
 }
 
 func (p *EfficientIPProvider) NewZoneAuth(zone eip.DnsZoneDataData) *ZoneAuth {
@@ -90,20 +319,34 @@ func (p *EfficientIPProvider) NewZoneAuth(zone eip.DnsZoneDataData) *ZoneAuth {
 		Name: zone.GetZoneName(),
 		Type: zone.GetZoneType(),
 		ID:   zone.GetZoneId(),
+		View: zone.GetDnsviewName(),
 	}
 }
 
 func (p *EfficientIPProvider) Zones(_ context.Context) ([]*ZoneAuth, error) {
 	var result []*ZoneAuth
 
-	zones, _, err := p.client.DnsApi.DnsZoneList(p.context).Execute()
+	request := p.client.DnsApi.DnsZoneList(p.context)
+	if p.view != "" {
+		request = request.Where("dnsview_name='" + p.view + "'")
+	}
+	zones, _, err := retryOnTransientError(request.Execute)
 
-	if err.Error() != "" && !zones.GetSuccess() {
+	if err != nil {
 		return nil, err
 	}
 
 	for _, zone := range zones.GetData() {
-		if !p.domainFilter.Match(zone.GetZoneName()) {
+		if isReverseZone(zone.GetZoneName()) {
+			if !p.managePTR {
+				log.Debugf("Ignore reverse zone [%s]: PTR management is disabled", zone.GetZoneName())
+				continue
+			}
+			if len(p.reverseZones) > 0 && !matchesAnySuffix(zone.GetZoneName(), p.reverseZones) {
+				log.Debugf("Ignore reverse zone [%s] by reverseCIDRFilter", zone.GetZoneName())
+				continue
+			}
+		} else if !p.domainFilter.Match(zone.GetZoneName()) {
 			log.Debugf("Ignore zone [%s] by domainFilter", zone.GetZoneName())
 			continue
 		}
@@ -111,12 +354,102 @@ func (p *EfficientIPProvider) Zones(_ context.Context) ([]*ZoneAuth, error) {
 			log.Debugf("Ignore zone [%s][%s] by zoneIDFilter", zone.GetZoneName(), zone.GetZoneId())
 			continue
 		}
+		if p.viewFilter != "" && !strings.Contains(zone.GetDnsviewName(), p.viewFilter) {
+			log.Debugf("Ignore zone [%s] by viewFilter", zone.GetZoneName())
+			continue
+		}
 		result = append(result, p.NewZoneAuth(zone))
 	}
 	return result, nil
 }
 
+// rrRecord is the subset of a SOLIDserver RR list entry that aggregateRecords
+// needs. Extracting it from the generated API response type keeps the
+// aggregation logic unit-testable without a live client.
+type rrRecord struct {
+	fullName string
+	rrType   string
+	ttl      string
+	allValue string
+	value1   string
+	value2   string
+	value3   string
+	value4   string
+}
+
+// aggregateRecords turns a zone's flat RR list into Endpoints, merging
+// multi-target record types (A, AAAA, CNAME, TXT, NS, MX, SRV, CAA, PTR) by
+// (name, type) and reconstituting MX/SRV/CAA RDATA from their RrValue1..4
+// fields.
+func aggregateRecords(records []rrRecord) []*endpoint.Endpoint {
+	var endpoints []*endpoint.Endpoint
+	aggregated := make(map[string]*endpoint.Endpoint)
+	for _, rr := range records {
+		ttl, _ := strconv.Atoi(rr.ttl)
+
+		value := rr.allValue
+		switch rr.rrType {
+		case endpoint.RecordTypeMX, endpoint.RecordTypeSRV, "CAA":
+			value = rrValueFromFields(rr.rrType, rr.value1, rr.value2, rr.value3, rr.value4)
+		}
+		log.Debugf("Found %s Record : %s -> %s", rr.rrType, rr.fullName, value)
+
+		if !multiValueRecordTypes[rr.rrType] {
+			endpoints = append(endpoints, endpoint.NewEndpointWithTTL(rr.fullName, rr.rrType, endpoint.TTL(ttl), value))
+			continue
+		}
+
+		key := rr.fullName + ":" + rr.rrType
+		if ep, found := aggregated[key]; found {
+			ep.Targets = append(ep.Targets, value)
+		} else {
+			aggregated[key] = endpoint.NewEndpointWithTTL(rr.fullName, rr.rrType, endpoint.TTL(ttl), value)
+		}
+	}
+	for _, ep := range aggregated {
+		endpoints = append(endpoints, ep)
+	}
+	return endpoints
+}
+
+// recordsForZone fetches and aggregates the RRs of a single zone.
+func (p *EfficientIPProvider) recordsForZone(zone *ZoneAuth) ([]*endpoint.Endpoint, error) {
+	where := "zone_id=" + zone.ID
+	if p.view != "" {
+		where += " AND dnsview_name='" + p.view + "'"
+	}
+	request := p.client.DnsApi.DnsRrList(p.context).Where(where).Orderby("rr_full_name")
+	records, _, err := retryOnTransientError(request.Execute)
+	if err != nil {
+		log.Errorf("Failed to get RRs for zone [%s]", zone.Name)
+		return nil, err
+	}
+
+	rrRecords := make([]rrRecord, 0, len(records.GetData()))
+	for _, rr := range records.GetData() {
+		rrRecords = append(rrRecords, rrRecord{
+			fullName: rr.GetRrFullName(),
+			rrType:   rr.GetRrType(),
+			ttl:      rr.GetRrTtl(),
+			allValue: rr.GetRrAllValue(),
+			value1:   rr.GetRrValue1(),
+			value2:   rr.GetRrValue2(),
+			value3:   rr.GetRrValue3(),
+			value4:   rr.GetRrValue4(),
+		})
+	}
+
+	return aggregateRecords(rrRecords), nil
+}
+
 // Records gets the current records.
+//
+// Reverse zones are deliberately excluded here even when ManagePTR is on:
+// their PTRs are created and deleted out-of-band by maybeSyncPTR as
+// companions to their owning A/AAAA record, with no registry ownership TXT
+// of their own. Surfacing them through Records() as well would make the
+// plan see them as current-but-unsourced under --policy=sync, and the two
+// mechanisms would fight over the same RR on alternating reconciles.
 func (p *EfficientIPProvider) Records(ctx context.Context) (endpoints []*endpoint.Endpoint, _ error) {
 	log.Debug("Get Record list from EfficientIP SOLIDserver")
 
@@ -126,43 +459,121 @@ func (p *EfficientIPProvider) Records(ctx context.Context) (endpoints []*endpoin
 		return nil, err
 	}
 
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, p.parallelism())
+		errs []error
+	)
+
 	for _, zone := range zones {
-		records, _, err := p.client.DnsApi.DnsRrList(p.context).Where("zone_id=" + zone.ID).Orderby("rr_full_name").Execute()
-		if err.Error() != "" && !records.GetSuccess() {
-			log.Errorf("Failed to get RRs for zone [%s]", zone.Name)
-			return nil, err
+		zone := zone
+		if isReverseZone(zone.Name) {
+			continue
 		}
-
-		Host := make(map[string]*endpoint.Endpoint)
-		for _, rr := range records.GetData() {
-			ttl, _ := strconv.Atoi(rr.GetRrTtl())
-
-			switch rr.GetRrType() {
-			case "A":
-				log.Debugf("Found A Record : %s -> %s", rr.GetRrFullName(), rr.GetRrAllValue())
-				if h, found := Host[rr.GetRrFullName()+":"+rr.GetRrType()]; found {
-					h.Targets = append(h.Targets, rr.GetRrAllValue())
-				} else {
-					Host[rr.GetRrFullName()+":"+rr.GetRrType()] = endpoint.NewEndpointWithTTL(rr.GetRrFullName(), endpoint.RecordTypeA, endpoint.TTL(ttl), rr.GetRrAllValue())
-				}
-			case "TXT":
-				log.Debugf("Found TXT Record : %s -> %s", rr.GetRrFullName(), rr.GetRrAllValue())
-				tmp := endpoint.NewEndpointWithTTL(rr.GetRrFullName(), endpoint.RecordTypeTXT, endpoint.TTL(ttl), rr.GetRrAllValue())
-				endpoints = append(endpoints, tmp)
-			default:
-				log.Debugf("Found %s Record : %s -> %s", rr.GetRrType(), rr.GetRrFullName(), rr.GetRrAllValue())
-				endpoints = append(endpoints, endpoint.NewEndpointWithTTL(rr.GetRrFullName(), rr.GetRrType(), endpoint.TTL(ttl), rr.GetRrAllValue()))
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			zoneEndpoints, err := p.recordsForZone(zone)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				errs = append(errs, err)
+				return
 			}
+			endpoints = append(endpoints, zoneEndpoints...)
+		}()
+	}
+	wg.Wait()
+
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
+// zoneForName returns the zone from zones whose name is the longest suffix of
+// fqdn, analogous to lego's ExtractSubDomain. It returns an error when no
+// zone in the cached list matches, so an endpoint is never sent to the wrong
+// (or to an arbitrarily chosen) zone.
+func zoneForName(zones []*ZoneAuth, fqdn string) (*ZoneAuth, error) {
+	var best *ZoneAuth
+	for _, zone := range zones {
+		if fqdn != zone.Name && !strings.HasSuffix(fqdn, "."+zone.Name) {
+			continue
 		}
-		for _, rr := range Host {
-			endpoints = append(endpoints, rr)
+		if best == nil || len(zone.Name) > len(best.Name) {
+			best = zone
 		}
 	}
+	if best == nil {
+		return nil, fmt.Errorf("no zone matches %q", fqdn)
+	}
+	return best, nil
+}
 
-	return endpoints, nil
+// maybeSyncPTR creates or deletes the companion PTR record for an A/AAAA
+// target when PTR management is enabled, mirroring --rfc2136-create-ptr in
+// other providers. A missing reverse zone is logged and skipped rather than
+// failing the forward record change.
+func (p *EfficientIPProvider) maybeSyncPTR(zones []*ZoneAuth, changes *endpoint.Endpoint, value string, create bool) {
+	if !p.managePTR || (changes.RecordType != endpoint.RecordTypeA && changes.RecordType != endpoint.RecordTypeAAAA) {
+		return
+	}
+
+	reverseName, err := reverseNameForIP(value)
+	if err != nil {
+		log.Errorf("Failed to compute PTR name for %s record %s -> %s: %v", changes.RecordType, changes.DNSName, value, err)
+		return
+	}
+
+	reverseZone, err := zoneForName(zones, reverseName)
+	if err != nil {
+		log.Debugf("No reverse zone for %s, skipping PTR sync for %s", reverseName, changes.DNSName)
+		return
+	}
+
+	if !create {
+		deleteRequest := p.client.DnsApi.DnsRrDelete(p.context).RrName(reverseName).RrType(endpoint.RecordTypePTR).
+			ZoneId(reverseZone.ID).RrValue1(changes.DNSName)
+		if p.view != "" {
+			deleteRequest = deleteRequest.DnsviewName(p.view)
+		}
+		if _, _, err := retryOnTransientError(deleteRequest.Execute); err != nil {
+			log.Errorf("Deletion of the companion PTR record %s -> %s failed: %v", reverseName, changes.DNSName, err)
+		}
+		return
+	}
+
+	ttl := int32(changes.RecordTTL)
+	rrType := endpoint.RecordTypePTR
+	rrAddInput := eip.DnsRrAddInput{
+		RrName:   &reverseName,
+		RrType:   &rrType,
+		RrTtl:    &ttl,
+		ZoneId:   &reverseZone.ID,
+		RrValue1: &changes.DNSName,
+	}
+	if p.view != "" {
+		rrAddInput.DnsviewName = &p.view
+	}
+	addRequest := p.client.DnsApi.DnsRrAdd(p.context).DnsRrAddInput(rrAddInput)
+	if _, _, err := retryOnTransientError(addRequest.Execute); err != nil {
+		log.Errorf("Creation of the companion PTR record %s -> %s failed: %v", reverseName, changes.DNSName, err)
+	}
 }
 
-func (p *EfficientIPProvider) DeleteChanges(_ context.Context, changes *endpoint.Endpoint) error {
+func (p *EfficientIPProvider) DeleteChanges(_ context.Context, zones []*ZoneAuth, changes *endpoint.Endpoint) error {
+	zone, err := zoneForName(zones, changes.DNSName)
+	if err != nil {
+		return err
+	}
+
 	for _, value := range changes.Targets {
 		if p.dryRun {
 			log.Infof("Would delete %s record named '%s' to '%s' for Efficientip",
@@ -179,15 +590,47 @@ func (p *EfficientIPProvider) DeleteChanges(_ context.Context, changes *endpoint
 			value,
 		)
 
-		_, _, err := p.client.DnsApi.DnsRrDelete(p.context).RrName(changes.DNSName).RrType(changes.RecordType).RrValue1(value).Execute()
-		if err.Error() != "" {
+		v1, v2, v3, v4, err := rrFieldsFromValue(changes.RecordType, value)
+		if err != nil {
+			log.Errorf("Deletion of the RR %v %v -> %v : %v", changes.RecordType, changes.DNSName, value, err)
+			continue
+		}
+
+		deleteRequest := p.client.DnsApi.DnsRrDelete(p.context).RrName(changes.DNSName).RrType(changes.RecordType).
+			ZoneId(zone.ID).RrValue1(v1)
+		// Only set RrValue2..4 for the composite types that actually split
+		// across them (MX/SRV/CAA); sending non-nil empty-string pointers for
+		// the common single-value types would add rr_value2/3/4= to the wire
+		// request and change behavior SOLIDserver has always seen as absent.
+		if v2 != "" {
+			deleteRequest = deleteRequest.RrValue2(v2)
+		}
+		if v3 != "" {
+			deleteRequest = deleteRequest.RrValue3(v3)
+		}
+		if v4 != "" {
+			deleteRequest = deleteRequest.RrValue4(v4)
+		}
+		if p.view != "" {
+			deleteRequest = deleteRequest.DnsviewName(p.view)
+		}
+		_, _, err = retryOnTransientError(deleteRequest.Execute)
+		if err != nil {
 			log.Errorf("Deletion of the RR %v %v -> %v : failed!", changes.RecordType, changes.DNSName, value)
+			continue
 		}
+
+		p.maybeSyncPTR(zones, changes, value, false)
 	}
 	return nil
 }
 
-func (p *EfficientIPProvider) CreateChanges(_ context.Context, changes *endpoint.Endpoint) error {
+func (p *EfficientIPProvider) CreateChanges(_ context.Context, zones []*ZoneAuth, changes *endpoint.Endpoint) error {
+	zone, err := zoneForName(zones, changes.DNSName)
+	if err != nil {
+		return err
+	}
+
 	for _, value := range changes.Targets {
 		if p.dryRun {
 			log.Infof("Would create %s record named '%s' to '%s' for Efficientip",
@@ -204,46 +647,100 @@ func (p *EfficientIPProvider) CreateChanges(_ context.Context, changes *endpoint
 			value,
 		)
 
+		v1, v2, v3, v4, err := rrFieldsFromValue(changes.RecordType, value)
+		if err != nil {
+			log.Errorf("Creation of the RR %v %v -> %v : %v", changes.RecordType, changes.DNSName, value, err)
+			continue
+		}
+
 		ttl := int32(changes.RecordTTL)
-		_, _, err := p.client.DnsApi.DnsRrAdd(p.context).DnsRrAddInput(eip.DnsRrAddInput{
+		rrAddInput := eip.DnsRrAddInput{
 			RrName:   &changes.DNSName,
 			RrType:   &changes.RecordType,
 			RrTtl:    &ttl,
-			RrValue1: &value,
-		}).Execute()
+			ZoneId:   &zone.ID,
+			RrValue1: &v1,
+		}
+		// Only set RrValue2..4 for the composite types that actually split
+		// across them (MX/SRV/CAA); sending non-nil empty-string pointers for
+		// the common single-value types would add rr_value2/3/4= to the wire
+		// request and change behavior SOLIDserver has always seen as absent.
+		if v2 != "" {
+			rrAddInput.RrValue2 = &v2
+		}
+		if v3 != "" {
+			rrAddInput.RrValue3 = &v3
+		}
+		if v4 != "" {
+			rrAddInput.RrValue4 = &v4
+		}
+		if p.view != "" {
+			rrAddInput.DnsviewName = &p.view
+		}
+		addRequest := p.client.DnsApi.DnsRrAdd(p.context).DnsRrAddInput(rrAddInput)
+		_, _, err = retryOnTransientError(addRequest.Execute)
 
-		if err.Error() != "" {
+		if err != nil {
 			log.Errorf("Creation of the RR %v %v  [%v]-> %v : failed!", changes.RecordType, changes.DNSName, ttl, value)
+			continue
 		}
+
+		p.maybeSyncPTR(zones, changes, value, true)
 	}
 	return nil
 }
 
+// applyConcurrent runs apply over changeList with up to p.parallelism() changes
+// in flight at once, aggregating every failure instead of bailing out on the
+// first one.
+func (p *EfficientIPProvider) applyConcurrent(ctx context.Context, zones []*ZoneAuth, changeList []*endpoint.Endpoint, apply func(context.Context, []*ZoneAuth, *endpoint.Endpoint) error) error {
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		sem  = make(chan struct{}, p.parallelism())
+		errs []error
+	)
+
+	for _, change := range changeList {
+		change := change
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := apply(ctx, zones, change); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return utilerrors.NewAggregate(errs)
+}
+
 // ApplyChanges applies the given changes.
 func (p *EfficientIPProvider) ApplyChanges(ctx context.Context, changes *plan.Changes) error {
-	for _, change := range changes.Delete {
-		err := p.DeleteChanges(ctx, change)
-		if err != nil {
-			return err
-		}
+	// Fetch the zone list once per invocation; every endpoint below is
+	// matched against this cached set rather than re-listing zones per RR.
+	zones, err := p.Zones(ctx)
+	if err != nil {
+		return err
 	}
-	for _, change := range changes.UpdateOld {
-		err := p.DeleteChanges(ctx, change)
-		if err != nil {
-			return err
-		}
+
+	if err := p.applyConcurrent(ctx, zones, changes.Delete, p.DeleteChanges); err != nil {
+		return err
 	}
-	for _, change := range changes.UpdateNew {
-		err := p.CreateChanges(ctx, change)
-		if err != nil {
-			return err
-		}
+	if err := p.applyConcurrent(ctx, zones, changes.UpdateOld, p.DeleteChanges); err != nil {
+		return err
 	}
-	for _, change := range changes.Create {
-		err := p.CreateChanges(ctx, change)
-		if err != nil {
-			return err
-		}
+	if err := p.applyConcurrent(ctx, zones, changes.UpdateNew, p.CreateChanges); err != nil {
+		return err
+	}
+	if err := p.applyConcurrent(ctx, zones, changes.Create, p.CreateChanges); err != nil {
+		return err
 	}
 
 	return nil
@@ -0,0 +1,166 @@
+/*
+Copyright 2017 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package efficientip
+
+import (
+	"sort"
+	"testing"
+
+	"sigs.k8s.io/external-dns/endpoint"
+)
+
+func TestRrValueFromFields(t *testing.T) {
+	tests := []struct {
+		rrType                         string
+		value1, value2, value3, value4 string
+		want                           string
+	}{
+		{endpoint.RecordTypeMX, "10", "mail.example.com", "", "", "10 mail.example.com"},
+		{endpoint.RecordTypeSRV, "0", "5", "5060", "sip.example.com", "0 5 5060 sip.example.com"},
+		{"CAA", "0", "issue", "letsencrypt.org", "", `0 issue "letsencrypt.org"`},
+	}
+	for _, tt := range tests {
+		got := rrValueFromFields(tt.rrType, tt.value1, tt.value2, tt.value3, tt.value4)
+		if got != tt.want {
+			t.Errorf("rrValueFromFields(%s, ...) = %q, want %q", tt.rrType, got, tt.want)
+		}
+	}
+}
+
+func TestRrFieldsFromValue(t *testing.T) {
+	t.Run("MX round-trip", func(t *testing.T) {
+		v1, v2, v3, v4, err := rrFieldsFromValue(endpoint.RecordTypeMX, "10 mail.example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v1 != "10" || v2 != "mail.example.com" || v3 != "" || v4 != "" {
+			t.Fatalf("got (%q, %q, %q, %q)", v1, v2, v3, v4)
+		}
+		if got := rrValueFromFields(endpoint.RecordTypeMX, v1, v2, v3, v4); got != "10 mail.example.com" {
+			t.Fatalf("round-trip mismatch: got %q", got)
+		}
+	})
+
+	t.Run("SRV round-trip", func(t *testing.T) {
+		v1, v2, v3, v4, err := rrFieldsFromValue(endpoint.RecordTypeSRV, "0 5 5060 sip.example.com")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := rrValueFromFields(endpoint.RecordTypeSRV, v1, v2, v3, v4); got != "0 5 5060 sip.example.com" {
+			t.Fatalf("round-trip mismatch: got %q", got)
+		}
+	})
+
+	t.Run("CAA round-trip", func(t *testing.T) {
+		v1, v2, v3, v4, err := rrFieldsFromValue("CAA", `0 issue "letsencrypt.org"`)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v3 != "letsencrypt.org" {
+			t.Fatalf("expected unquoted value3, got %q", v3)
+		}
+		if got := rrValueFromFields("CAA", v1, v2, v3, v4); got != `0 issue "letsencrypt.org"` {
+			t.Fatalf("round-trip mismatch: got %q", got)
+		}
+	})
+
+	t.Run("A passthrough", func(t *testing.T) {
+		v1, v2, v3, v4, err := rrFieldsFromValue(endpoint.RecordTypeA, "1.2.3.4")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v1 != "1.2.3.4" || v2 != "" || v3 != "" || v4 != "" {
+			t.Fatalf("got (%q, %q, %q, %q)", v1, v2, v3, v4)
+		}
+	})
+
+	t.Run("invalid MX value", func(t *testing.T) {
+		if _, _, _, _, err := rrFieldsFromValue(endpoint.RecordTypeMX, "mail.example.com"); err == nil {
+			t.Fatal("expected an error for a malformed MX value")
+		}
+	})
+}
+
+func TestAggregateRecords(t *testing.T) {
+	records := []rrRecord{
+		{fullName: "www.example.com", rrType: endpoint.RecordTypeA, ttl: "300", allValue: "1.1.1.1"},
+		{fullName: "www.example.com", rrType: endpoint.RecordTypeA, ttl: "300", allValue: "2.2.2.2"},
+		{fullName: "www6.example.com", rrType: endpoint.RecordTypeAAAA, ttl: "300", allValue: "::1"},
+		{fullName: "alias.example.com", rrType: endpoint.RecordTypeCNAME, ttl: "300", allValue: "www.example.com"},
+		{fullName: "txt.example.com", rrType: endpoint.RecordTypeTXT, ttl: "300", allValue: "heritage=a"},
+		{fullName: "txt.example.com", rrType: endpoint.RecordTypeTXT, ttl: "300", allValue: "heritage=b"},
+		{fullName: "example.com", rrType: endpoint.RecordTypeNS, ttl: "300", allValue: "ns1.example.com"},
+		{fullName: "example.com", rrType: endpoint.RecordTypeMX, ttl: "300", value1: "10", value2: "mail.example.com"},
+		{fullName: "_sip._tcp.example.com", rrType: endpoint.RecordTypeSRV, ttl: "300", value1: "0", value2: "5", value3: "5060", value4: "sip.example.com"},
+		{fullName: "example.com", rrType: "CAA", ttl: "300", value1: "0", value2: "issue", value3: "letsencrypt.org"},
+		{fullName: "1.1.1.1.in-addr.arpa", rrType: endpoint.RecordTypePTR, ttl: "300", allValue: "www.example.com"},
+	}
+
+	endpoints := aggregateRecords(records)
+
+	byKey := make(map[string]*endpoint.Endpoint)
+	for _, ep := range endpoints {
+		byKey[ep.DNSName+":"+ep.RecordType] = ep
+	}
+
+	a := byKey["www.example.com:"+endpoint.RecordTypeA]
+	if a == nil {
+		t.Fatal("expected an aggregated A endpoint")
+	}
+	gotTargets := append([]string(nil), a.Targets...)
+	sort.Strings(gotTargets)
+	if len(gotTargets) != 2 || gotTargets[0] != "1.1.1.1" || gotTargets[1] != "2.2.2.2" {
+		t.Fatalf("expected A targets [1.1.1.1 2.2.2.2], got %v", gotTargets)
+	}
+
+	if aaaa := byKey["www6.example.com:"+endpoint.RecordTypeAAAA]; aaaa == nil || aaaa.Targets[0] != "::1" {
+		t.Fatalf("expected AAAA target ::1, got %+v", aaaa)
+	}
+
+	if cname := byKey["alias.example.com:"+endpoint.RecordTypeCNAME]; cname == nil || cname.Targets[0] != "www.example.com" {
+		t.Fatalf("expected CNAME target www.example.com, got %+v", cname)
+	}
+
+	txt := byKey["txt.example.com:"+endpoint.RecordTypeTXT]
+	if txt == nil || len(txt.Targets) != 2 {
+		t.Fatalf("expected 2 aggregated TXT targets, got %+v", txt)
+	}
+
+	if ns := byKey["example.com:"+endpoint.RecordTypeNS]; ns == nil || ns.Targets[0] != "ns1.example.com" {
+		t.Fatalf("expected NS target ns1.example.com, got %+v", ns)
+	}
+
+	mx := byKey["example.com:"+endpoint.RecordTypeMX]
+	if mx == nil || mx.Targets[0] != "10 mail.example.com" {
+		t.Fatalf("expected MX round-trip target %q, got %+v", "10 mail.example.com", mx)
+	}
+
+	srv := byKey["_sip._tcp.example.com:"+endpoint.RecordTypeSRV]
+	if srv == nil || srv.Targets[0] != "0 5 5060 sip.example.com" {
+		t.Fatalf("expected SRV round-trip target, got %+v", srv)
+	}
+
+	caa := byKey["example.com:CAA"]
+	if caa == nil || caa.Targets[0] != `0 issue "letsencrypt.org"` {
+		t.Fatalf("expected CAA round-trip target %q, got %+v", `0 issue "letsencrypt.org"`, caa)
+	}
+
+	ptr := byKey["1.1.1.1.in-addr.arpa:"+endpoint.RecordTypePTR]
+	if ptr == nil || ptr.Targets[0] != "www.example.com" {
+		t.Fatalf("expected PTR target www.example.com, got %+v", ptr)
+	}
+}